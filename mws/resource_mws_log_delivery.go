@@ -16,21 +16,33 @@ type LogDelivery struct {
 	LogDeliveryConfiguration LogDeliveryConfiguration `json:"log_delivery_configuration"`
 }
 
-// LogDeliveryConfiguration describes log delivery
+// LogDeliveryConfiguration describes log delivery. PipelineIdsFilter and Target
+// exist on the wire format for PIPELINE_EVENTS deliveries (see
+// PipelineEventLogDeliveryConfiguration in resource_mws_pipeline_event_log_delivery.go)
+// but are deliberately left out of ResourceMwsLogDelivery's schema below: the generic
+// databricks_mws_log_delivery resource only ever manages BILLABLE_USAGE/AUDIT_LOGS.
 type LogDeliveryConfiguration struct {
-	AccountID              string  `json:"account_id"`
-	ConfigID               string  `json:"config_id,omitempty" tf:"computed"`
-	CredentialsID          string  `json:"credentials_id"`
-	StorageConfigurationID string  `json:"storage_configuration_id"`
-	WorkspaceIdsFilter     []int64 `json:"workspace_ids_filter,omitempty"`
-	ConfigName             string  `json:"config_name,omitempty"`
-	Status                 string  `json:"status,omitempty" tf:"computed"`
-	LogType                string  `json:"log_type"`
-	OutputFormat           string  `json:"output_format"`
-	DeliveryPathPrefix     string  `json:"delivery_path_prefix,omitempty"`
-	DeliveryStartTime      string  `json:"delivery_start_time,omitempty" tf:"computed,force_new"`
+	AccountID              string   `json:"account_id"`
+	ConfigID               string   `json:"config_id,omitempty" tf:"computed"`
+	CredentialsID          string   `json:"credentials_id"`
+	StorageConfigurationID string   `json:"storage_configuration_id"`
+	WorkspaceIdsFilter     []int64  `json:"workspace_ids_filter,omitempty"`
+	PipelineIdsFilter      []string `json:"pipeline_ids_filter,omitempty"`
+	ConfigName             string   `json:"config_name,omitempty"`
+	Status                 string   `json:"status,omitempty" tf:"computed"`
+	LogType                string   `json:"log_type"`
+	OutputFormat           string   `json:"output_format"`
+	Target                 string   `json:"target,omitempty"`
+	DeliveryPathPrefix     string   `json:"delivery_path_prefix,omitempty"`
+	DeliveryStartTime      string   `json:"delivery_start_time,omitempty" tf:"computed,force_new"`
 }
 
+// genericLogTypes are the log_type values the generic databricks_mws_log_delivery
+// resource supports. PIPELINE_EVENTS is intentionally excluded here: it's only
+// reachable through databricks_mws_pipeline_event_log_delivery, which carries its
+// own pipeline-existence check and pipeline_id-keyed shape.
+var genericLogTypes = []string{"BILLABLE_USAGE", "AUDIT_LOGS"}
+
 // LogDeliveryAPI ...
 type LogDeliveryAPI struct {
 	client  *common.DatabricksClient
@@ -72,10 +84,15 @@ func ResourceMwsLogDelivery() *schema.Resource {
 		func(s map[string]*schema.Schema) map[string]*schema.Schema {
 			// nolint
 			s["config_name"].ValidateFunc = validation.StringLenBetween(0, 255)
+			s["log_type"].ValidateFunc = validation.StringInSlice(genericLogTypes, false)
 			s["delivery_start_time"].DiffSuppressFunc = func(
 				k, old, new string, d *schema.ResourceData) bool {
 				return false
 			}
+			// pipeline-scoped fields are only exposed through
+			// databricks_mws_pipeline_event_log_delivery, not this generic resource.
+			delete(s, "pipeline_ids_filter")
+			delete(s, "target")
 			return s
 		})
 	return common.Resource{
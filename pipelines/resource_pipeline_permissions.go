@@ -0,0 +1,221 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/permissions"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// accessControlChange is shared by the inline access_control block on
+// databricks_pipeline and the standalone databricks_pipeline_permissions resource.
+type accessControlChange struct {
+	UserName             string `json:"user_name,omitempty"`
+	GroupName            string `json:"group_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+	PermissionLevel      string `json:"permission_level"`
+}
+
+func toPermissionsChanges(changes []accessControlChange) []permissions.AccessControlChange {
+	out := make([]permissions.AccessControlChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, permissions.AccessControlChange{
+			UserName:             c.UserName,
+			GroupName:            c.GroupName,
+			ServicePrincipalName: c.ServicePrincipalName,
+			PermissionLevel:      c.PermissionLevel,
+		})
+	}
+	return out
+}
+
+func fromPermissionsACL(acl permissions.ObjectACL) []accessControlChange {
+	out := make([]accessControlChange, 0, len(acl.AccessControlList))
+	for _, e := range acl.AccessControlList {
+		for _, p := range e.AllPermissions {
+			if !p.Inherited {
+				out = append(out, accessControlChange{
+					UserName:             e.UserName,
+					GroupName:            e.GroupName,
+					ServicePrincipalName: e.ServicePrincipalName,
+					PermissionLevel:      p.PermissionLevel,
+				})
+			}
+		}
+	}
+	return out
+}
+
+// aclOwner identifies which of the two resources that can manage a pipeline's ACL
+// (the inline access_control block on databricks_pipeline, or the standalone
+// databricks_pipeline_permissions) is doing so.
+type aclOwner string
+
+const (
+	aclOwnerInlinePipeline        aclOwner = "databricks_pipeline.access_control"
+	aclOwnerStandalonePermissions aclOwner = "databricks_pipeline_permissions"
+)
+
+// aclOwnerConfigKey is where the current aclOwner is durably recorded, in the
+// pipeline's own Configuration map. A same-process in-memory map can't catch a
+// conflict between two separate terraform apply runs -- each gets a fresh provider
+// process -- so the pipeline itself, the one piece of state both resources already
+// read on every Create/Update, is what actually carries this across applies.
+const aclOwnerConfigKey = "__databricks_terraform_acl_owner"
+
+// aclOwners mirrors the remote aclOwnerConfigKey value for the lifetime of this
+// provider process, so that a conflict with an owner already claimed in this process
+// is reported before any PipelinesAPI.Read. A claim that passes this check still
+// reads the pipeline's Configuration.
+var (
+	aclOwnersMu sync.Mutex
+	aclOwners   = map[string]aclOwner{}
+)
+
+func aclOwnerConflict(pipelineID string, existing aclOwner) error {
+	return fmt.Errorf("pipeline %s ACLs are already managed by %s; use either the access_control block on "+
+		"databricks_pipeline or a standalone databricks_pipeline_permissions resource for a given pipeline, not both",
+		pipelineID, existing)
+}
+
+// claimACLOwnership durably claims pipelineID's ACL for owner, checking both the
+// in-process cache and the pipeline's own Configuration (see aclOwnerConfigKey) so a
+// conflict with a resource applied in a prior, separate terraform apply is caught too.
+func claimACLOwnership(ctx context.Context, c *common.DatabricksClient, pipelineID string, owner aclOwner) error {
+	aclOwnersMu.Lock()
+	if existing, ok := aclOwners[pipelineID]; ok && existing != owner {
+		aclOwnersMu.Unlock()
+		return aclOwnerConflict(pipelineID, existing)
+	}
+	aclOwnersMu.Unlock()
+
+	api := NewPipelinesAPI(ctx, c)
+	i, err := api.Read(pipelineID)
+	if err != nil {
+		return err
+	}
+	if i.Spec == nil {
+		return fmt.Errorf("pipeline spec is nil for '%v'", pipelineID)
+	}
+	if existing := aclOwner(i.Spec.Configuration[aclOwnerConfigKey]); existing != "" && existing != owner {
+		return aclOwnerConflict(pipelineID, existing)
+	}
+
+	aclOwnersMu.Lock()
+	aclOwners[pipelineID] = owner
+	aclOwnersMu.Unlock()
+
+	if i.Spec.Configuration[aclOwnerConfigKey] == string(owner) {
+		return nil
+	}
+	return api.setACLOwnerConfig(pipelineID, i.Spec, owner)
+}
+
+// releaseACLOwnership clears owner's claim on pipelineID's ACL, both locally and in
+// the pipeline's own Configuration, so a later apply is free to assign a new owner.
+func releaseACLOwnership(ctx context.Context, c *common.DatabricksClient, pipelineID string, owner aclOwner) error {
+	aclOwnersMu.Lock()
+	if aclOwners[pipelineID] == owner {
+		delete(aclOwners, pipelineID)
+	}
+	aclOwnersMu.Unlock()
+
+	api := NewPipelinesAPI(ctx, c)
+	i, err := api.Read(pipelineID)
+	if err != nil {
+		return err
+	}
+	if i.Spec == nil || i.Spec.Configuration[aclOwnerConfigKey] != string(owner) {
+		return nil
+	}
+	return api.setACLOwnerConfig(pipelineID, i.Spec, "")
+}
+
+// setACLOwnerConfig persists aclOwnerConfigKey into spec.Configuration with a direct
+// PUT, not PipelinesAPI.Update: this is a metadata-only change, so it doesn't need
+// Update's active-run guard or its wait for the pipeline to reach RUNNING again.
+func (a PipelinesAPI) setACLOwnerConfig(id string, spec *pipelineSpec, owner aclOwner) error {
+	config := make(map[string]string, len(spec.Configuration)+1)
+	for k, v := range spec.Configuration {
+		config[k] = v
+	}
+	if owner == "" {
+		delete(config, aclOwnerConfigKey)
+	} else {
+		config[aclOwnerConfigKey] = string(owner)
+	}
+	next := *spec
+	next.Configuration = config
+	return a.client.Put(a.ctx, "/pipelines/"+id, next)
+}
+
+// applyAccessControl reconciles a pipeline's ACL against the desired list of
+// access_control entries, after claiming ownership of that pipeline's ACL for the
+// caller (see aclOwnerConfigKey above).
+func applyAccessControl(ctx context.Context, c *common.DatabricksClient, pipelineID string, changes []accessControlChange, owner aclOwner) error {
+	if err := claimACLOwnership(ctx, c, pipelineID, owner); err != nil {
+		return err
+	}
+	return permissions.NewPermissionsAPI(ctx, c).Update(
+		"pipelines/"+pipelineID,
+		permissions.AccessControlChangeList{
+			AccessControlList: toPermissionsChanges(changes),
+		})
+}
+
+type pipelinePermissions struct {
+	PipelineID    string                `json:"pipeline_id" tf:"force_new"`
+	AccessControl []accessControlChange `json:"access_control" tf:"slice_set,alias:access_control"`
+}
+
+// ResourcePipelinePermissions defines the `databricks_pipeline_permissions` resource,
+// for users who'd rather manage a pipeline's ACL out-of-line from the pipeline
+// definition itself rather than via the access_control block on databricks_pipeline.
+func ResourcePipelinePermissions() *schema.Resource {
+	s := common.StructToSchema(pipelinePermissions{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		accessControl, _ := m["access_control"].Elem.(*schema.Resource)
+		accessControl.Schema["permission_level"].ValidateFunc = validation.StringInSlice([]string{
+			"CAN_VIEW", "CAN_RUN", "CAN_MANAGE", "IS_OWNER",
+		}, false)
+		return m
+	})
+	reconcile := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var p pipelinePermissions
+		common.DataToStructPointer(d, s, &p)
+		return applyAccessControl(ctx, c, p.PipelineID, p.AccessControl, aclOwnerStandalonePermissions)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var p pipelinePermissions
+			common.DataToStructPointer(d, s, &p)
+			if err := applyAccessControl(ctx, c, p.PipelineID, p.AccessControl, aclOwnerStandalonePermissions); err != nil {
+				return err
+			}
+			d.SetId(p.PipelineID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			acl, err := permissions.NewPermissionsAPI(ctx, c).Read("pipelines/" + d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(pipelinePermissions{
+				PipelineID:    d.Id(),
+				AccessControl: fromPermissionsACL(acl),
+			}, s, d)
+		},
+		Update: reconcile,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := releaseACLOwnership(ctx, c, d.Id(), aclOwnerStandalonePermissions); err != nil {
+				return err
+			}
+			return permissions.NewPermissionsAPI(ctx, c).Delete("pipelines/" + d.Id())
+		},
+	}.ToResource()
+}